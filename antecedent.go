@@ -0,0 +1,210 @@
+package gofuzzymind
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AntecedentExpr is a node in a parsed rule antecedent expression. It
+// evaluates to a firing strength given crisp inputs and the T-norm/S-norm/
+// negation to combine sub-expressions with.
+type AntecedentExpr interface {
+	Eval(inputs map[string]float64, tnorm TNorm, snorm SNorm, neg Negation) float64
+	String() string
+}
+
+type atomExpr struct {
+	Variable string
+	SetName  string
+	Set      *FuzzySet
+}
+
+func (a *atomExpr) Eval(inputs map[string]float64, tnorm TNorm, snorm SNorm, neg Negation) float64 {
+	return a.Set.MembershipDegree(inputs[a.Variable])
+}
+
+func (a *atomExpr) String() string {
+	return a.Variable + " is " + a.SetName
+}
+
+type andExpr struct {
+	Left, Right AntecedentExpr
+}
+
+func (e *andExpr) Eval(inputs map[string]float64, tnorm TNorm, snorm SNorm, neg Negation) float64 {
+	return tnorm(e.Left.Eval(inputs, tnorm, snorm, neg), e.Right.Eval(inputs, tnorm, snorm, neg))
+}
+
+func (e *andExpr) String() string {
+	return "(" + e.Left.String() + " AND " + e.Right.String() + ")"
+}
+
+type orExpr struct {
+	Left, Right AntecedentExpr
+}
+
+func (e *orExpr) Eval(inputs map[string]float64, tnorm TNorm, snorm SNorm, neg Negation) float64 {
+	return snorm(e.Left.Eval(inputs, tnorm, snorm, neg), e.Right.Eval(inputs, tnorm, snorm, neg))
+}
+
+func (e *orExpr) String() string {
+	return "(" + e.Left.String() + " OR " + e.Right.String() + ")"
+}
+
+type notExpr struct {
+	Operand AntecedentExpr
+}
+
+func (e *notExpr) Eval(inputs map[string]float64, tnorm TNorm, snorm SNorm, neg Negation) float64 {
+	return neg(e.Operand.Eval(inputs, tnorm, snorm, neg))
+}
+
+func (e *notExpr) String() string {
+	return "NOT " + e.Operand.String()
+}
+
+// ParseAntecedent parses an antecedent expression such as
+// "HP is Low AND FP is High" or "NOT (HP is Low OR FP is High)" into an
+// AntecedentExpr, resolving each "Variable is Set" atom against the given
+// FuzzyVariables.
+func ParseAntecedent(expr string, variables map[string]*FuzzyVariable) (AntecedentExpr, error) {
+	p := &antecedentParser{tokens: tokenizeAntecedent(expr), variables: variables}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("gofuzzymind: unexpected token %q in antecedent %q", p.tokens[p.pos], expr)
+	}
+	return node, nil
+}
+
+func tokenizeAntecedent(s string) []string {
+	var tokens []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type antecedentParser struct {
+	tokens    []string
+	pos       int
+	variables map[string]*FuzzyVariable
+}
+
+func (p *antecedentParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *antecedentParser) peekIs(keyword string) bool {
+	return strings.EqualFold(p.peek(), keyword)
+}
+
+func (p *antecedentParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *antecedentParser) parseOr() (AntecedentExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekIs("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orExpr{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *antecedentParser) parseAnd() (AntecedentExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekIs("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andExpr{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *antecedentParser) parseNot() (AntecedentExpr, error) {
+	if p.peekIs("NOT") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{Operand: operand}, nil
+	}
+	return p.parseAtom()
+}
+
+func (p *antecedentParser) parseAtom() (AntecedentExpr, error) {
+	if p.peek() == "(" {
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("gofuzzymind: expected ')' in antecedent expression")
+		}
+		p.next()
+		return node, nil
+	}
+
+	varName := p.next()
+	if varName == "" {
+		return nil, fmt.Errorf("gofuzzymind: unexpected end of antecedent expression")
+	}
+	if !p.peekIs("is") {
+		return nil, fmt.Errorf("gofuzzymind: expected \"is\" after %q in antecedent expression", varName)
+	}
+	p.next()
+	setName := p.next()
+	if setName == "" {
+		return nil, fmt.Errorf("gofuzzymind: expected set name after \"%s is\"", varName)
+	}
+
+	variable, ok := p.variables[varName]
+	if !ok {
+		return nil, fmt.Errorf("gofuzzymind: antecedent references unknown variable %q", varName)
+	}
+	set := variable.Set(setName)
+	if set == nil {
+		return nil, fmt.Errorf("gofuzzymind: antecedent references unknown set %q of variable %q", setName, varName)
+	}
+	return &atomExpr{Variable: varName, SetName: setName, Set: set}, nil
+}
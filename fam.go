@@ -0,0 +1,44 @@
+package gofuzzymind
+
+import "fmt"
+
+// NewFAM builds a set of Rules from a Fuzzy Associative Matrix: a table
+// indexed by the names of the FuzzySets of two input FuzzyVariables, whose
+// cell values name a FuzzySet of the output FuzzyVariable. Each non-empty
+// cell becomes a rule whose antecedents are the corresponding input sets
+// (combined with the engine's T-norm at inference time) and whose
+// consequence is the named output FuzzySet.
+func NewFAM(inputs []*FuzzyVariable, output *FuzzyVariable, table map[string]map[string]string) ([]*Rule, error) {
+	if len(inputs) != 2 {
+		return nil, fmt.Errorf("gofuzzymind: NewFAM currently supports exactly 2 input variables, got %d", len(inputs))
+	}
+	rowVar, colVar := inputs[0], inputs[1]
+
+	var rules []*Rule
+	for rowSetName, row := range table {
+		rowSet := rowVar.Set(rowSetName)
+		if rowSet == nil {
+			return nil, fmt.Errorf("gofuzzymind: FAM row %q is not a set of variable %q", rowSetName, rowVar.Name)
+		}
+		for colSetName, outSetName := range row {
+			if outSetName == "" {
+				continue
+			}
+			colSet := colVar.Set(colSetName)
+			if colSet == nil {
+				return nil, fmt.Errorf("gofuzzymind: FAM column %q is not a set of variable %q", colSetName, colVar.Name)
+			}
+			outSet := output.Set(outSetName)
+			if outSet == nil {
+				return nil, fmt.Errorf("gofuzzymind: FAM cell names output set %q, not found in variable %q", outSetName, output.Name)
+			}
+
+			antecedents := map[string]*FuzzySet{
+				rowVar.Name: rowSet,
+				colVar.Name: colSet,
+			}
+			rules = append(rules, NewRule(antecedents, outSet, 1))
+		}
+	}
+	return rules, nil
+}
@@ -0,0 +1,96 @@
+package gofuzzymind
+
+import "testing"
+
+func famFixture() (hp, fp, action *FuzzyVariable) {
+	hp = NewFuzzyVariable("HP", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", StepDown(0, 50)),
+		"High": NewFuzzySet("High", StepUp(50, 100)),
+	})
+	fp = NewFuzzyVariable("FP", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", StepDown(0, 50)),
+		"High": NewFuzzySet("High", StepUp(50, 100)),
+	})
+	action = NewFuzzyVariable("Action", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Retreat": NewFuzzySet("Retreat", StepDown(0, 50)),
+		"Attack":  NewFuzzySet("Attack", StepUp(50, 100)),
+	})
+	return hp, fp, action
+}
+
+func TestNewFAM(t *testing.T) {
+	hp, fp, action := famFixture()
+	table := map[string]map[string]string{
+		"Low": {
+			"Low":  "Retreat",
+			"High": "Retreat",
+		},
+		"High": {
+			"Low":  "Attack",
+			"High": "Attack",
+		},
+	}
+
+	rules, err := NewFAM([]*FuzzyVariable{hp, fp}, action, table)
+	if err != nil {
+		t.Fatalf("NewFAM returned error: %v", err)
+	}
+	if len(rules) != 4 {
+		t.Fatalf("got %d rules, want 4", len(rules))
+	}
+
+	for _, rule := range rules {
+		if rule.Consequence == nil {
+			t.Errorf("rule %+v has nil consequence", rule)
+		}
+		if len(rule.Antecedents) != 2 {
+			t.Errorf("rule %+v should have 2 antecedents, got %d", rule, len(rule.Antecedents))
+		}
+	}
+}
+
+func TestNewFAMWrongInputCount(t *testing.T) {
+	hp, _, action := famFixture()
+	_, err := NewFAM([]*FuzzyVariable{hp}, action, map[string]map[string]string{})
+	if err == nil {
+		t.Fatal("expected an error for a single input variable, got nil")
+	}
+}
+
+func TestNewFAMUnknownNames(t *testing.T) {
+	hp, fp, action := famFixture()
+
+	if _, err := NewFAM([]*FuzzyVariable{hp, fp}, action, map[string]map[string]string{
+		"Unknown": {"Low": "Retreat"},
+	}); err == nil {
+		t.Error("expected an error for an unknown row set name")
+	}
+
+	if _, err := NewFAM([]*FuzzyVariable{hp, fp}, action, map[string]map[string]string{
+		"Low": {"Unknown": "Retreat"},
+	}); err == nil {
+		t.Error("expected an error for an unknown column set name")
+	}
+
+	if _, err := NewFAM([]*FuzzyVariable{hp, fp}, action, map[string]map[string]string{
+		"Low": {"Low": "Unknown"},
+	}); err == nil {
+		t.Error("expected an error for an unknown output set name")
+	}
+}
+
+func TestNewFAMSkipsEmptyCells(t *testing.T) {
+	hp, fp, action := famFixture()
+	rules, err := NewFAM([]*FuzzyVariable{hp, fp}, action, map[string]map[string]string{
+		"Low": {
+			"Low":  "Retreat",
+			"High": "",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewFAM returned error: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("got %d rules, want 1 (empty cell should be skipped)", len(rules))
+	}
+}
@@ -0,0 +1,357 @@
+package gofuzzymind
+
+import "math"
+
+// TNorm combines two membership degrees into one, modeling fuzzy AND.
+type TNorm func(a, b float64) float64
+
+// SNorm combines two membership degrees into one, modeling fuzzy OR.
+type SNorm func(a, b float64) float64
+
+// Implication derives a clipped or scaled output membership degree from a
+// rule's firing strength and its consequent's membership degree.
+type Implication func(strength, mu float64) float64
+
+// MinTNorm is the Zadeh T-norm: min(a, b).
+var MinTNorm TNorm = math.Min
+
+// ProductTNorm is the algebraic product T-norm: a*b.
+var ProductTNorm TNorm = func(a, b float64) float64 { return a * b }
+
+// MaxSNorm is the Zadeh S-norm: max(a, b).
+var MaxSNorm SNorm = math.Max
+
+// BoundedSumSNorm is the bounded-sum S-norm: min(1, a+b).
+var BoundedSumSNorm SNorm = func(a, b float64) float64 { return math.Min(1, a+b) }
+
+// MamdaniMin implements Mamdani implication by clipping the consequent's
+// membership function at the rule's firing strength.
+var MamdaniMin Implication = math.Min
+
+// LarsenProduct implements Larsen implication by scaling the consequent's
+// membership function by the rule's firing strength.
+var LarsenProduct Implication = func(strength, mu float64) float64 { return strength * mu }
+
+// Rule is a Mamdani fuzzy rule. Its antecedents are either a flat map of
+// input variable names to the FuzzySet each input is tested against (ANDed
+// together), or, when built from a parsed antecedent expression (see
+// ParseAntecedent), an Expr supporting AND/OR/NOT and parentheses. Its
+// consequence is the output FuzzySet it recommends when it fires.
+type Rule struct {
+	Antecedents map[string]*FuzzySet
+	Expr        AntecedentExpr
+	Consequence *FuzzySet
+	Weight      float64
+}
+
+// NewRule creates a new Rule from a flat conjunction of antecedents.
+// Weight scales the rule's firing strength; pass 1 for an unweighted rule
+// (a weight of 0 is kept as is, so such a rule never fires).
+func NewRule(antecedents map[string]*FuzzySet, consequence *FuzzySet, weight float64) *Rule {
+	return &Rule{Antecedents: antecedents, Consequence: consequence, Weight: weight}
+}
+
+// NewExprRule creates a new Rule from a parsed antecedent expression,
+// allowing AND/OR/NOT combinations beyond a flat conjunction.
+func NewExprRule(expr AntecedentExpr, consequence *FuzzySet, weight float64) *Rule {
+	return &Rule{Expr: expr, Consequence: consequence, Weight: weight}
+}
+
+// FiringStrength returns how strongly the rule's antecedents match inputs,
+// combining per-variable membership degrees with tnorm (and, for Expr-based
+// rules whose antecedent contains OR/NOT, snorm and the standard negation).
+func (r *Rule) FiringStrength(inputs map[string]float64, tnorm TNorm, snorm SNorm) float64 {
+	if r.Expr != nil {
+		return r.Expr.Eval(inputs, tnorm, snorm, StandardNegation) * r.Weight
+	}
+	return antecedentsFiringStrength(r.Antecedents, inputs, tnorm) * r.Weight
+}
+
+// antecedentsFiringStrength combines the membership degree of each
+// antecedent against inputs with tnorm, returning 0 for an empty
+// antecedent map. Shared by Rule and SugenoRule, whose flat,
+// map[string]*FuzzySet antecedents are evaluated identically.
+func antecedentsFiringStrength(antecedents map[string]*FuzzySet, inputs map[string]float64, tnorm TNorm) float64 {
+	strength := 1.0
+	first := true
+	for name, set := range antecedents {
+		degree := set.MembershipDegree(inputs[name])
+		if first {
+			strength = degree
+			first = false
+		} else {
+			strength = tnorm(strength, degree)
+		}
+	}
+	if first {
+		return 0
+	}
+	return strength
+}
+
+// InferenceEngine runs Mamdani inference over a set of Rules.
+type InferenceEngine struct {
+	Rules       []*Rule
+	TNorm       TNorm
+	SNorm       SNorm
+	Implication Implication
+
+	// spec holds the Spec this engine was built from, if any, so Spec()
+	// can return it for round-tripping. Nil for engines assembled
+	// directly through Go code.
+	spec *Spec
+
+	// lastSampleKey/lastSample memoize the most recent Sample call so the
+	// aggregate-based DefuzzifyX methods (Centroid, MOM, Bisector, SOM,
+	// LOM) can share one sweep per inference.
+	lastSampleKey sampleKey
+	lastSample    *SampledAggregate
+}
+
+// NewInferenceEngine creates a new InferenceEngine using the Zadeh T-norm,
+// Zadeh S-norm, and Mamdani min implication by default.
+func NewInferenceEngine(rules []*Rule) *InferenceEngine {
+	return &InferenceEngine{
+		Rules:       rules,
+		TNorm:       MinTNorm,
+		SNorm:       MaxSNorm,
+		Implication: MamdaniMin,
+	}
+}
+
+// Infer evaluates every rule against inputs and aggregates the fired,
+// clipped/scaled consequents into a single output FuzzySet ready for
+// defuzzification.
+func (ie *InferenceEngine) Infer(inputs map[string]float64) *FuzzySet {
+	type firing struct {
+		strength float64
+		set      *FuzzySet
+	}
+	var fired []firing
+	for _, rule := range ie.Rules {
+		strength := rule.FiringStrength(inputs, ie.TNorm, ie.SNorm)
+		if strength > 0 {
+			fired = append(fired, firing{strength: strength, set: rule.Consequence})
+		}
+	}
+
+	return NewFuzzySet("Aggregate", func(x float64) float64 {
+		mu := 0.0
+		first := true
+		for _, f := range fired {
+			implied := ie.Implication(f.strength, f.set.MembershipDegree(x))
+			if first {
+				mu = implied
+				first = false
+			} else {
+				mu = ie.SNorm(mu, implied)
+			}
+		}
+		return mu
+	})
+}
+
+// SampledAggregate is a single sweep of an output FuzzySet's membership
+// degree over a range of sample points. Sampling once and running several
+// defuzzifiers against the result (via Centroid, MOM, Bisector, SOM, LOM)
+// costs one sweep instead of one sweep per defuzzifier.
+type SampledAggregate struct {
+	X  []float64
+	Mu []float64
+}
+
+// sampleKey identifies a sweep of an aggregate FuzzySet over a range, so
+// InferenceEngine can recognize a repeat request and reuse the sweep.
+type sampleKey struct {
+	aggregate      *FuzzySet
+	min, max, step float64
+}
+
+// Sample sweeps aggregate's membership function from min to max in steps of
+// step. The engine remembers the most recent sweep, so calling Sample (and
+// therefore DefuzzifyCentroid, DefuzzifyMOM, DefuzzifyBisector,
+// DefuzzifySOM or DefuzzifyLOM) again with the same aggregate and range
+// -- as happens when running several defuzzifiers over one Infer result --
+// reuses it instead of re-sweeping. DefuzzifyWeightedAverage and
+// DefuzzifyHeight do not go through Sample.
+func (ie *InferenceEngine) Sample(aggregate *FuzzySet, min, max, step float64) *SampledAggregate {
+	key := sampleKey{aggregate: aggregate, min: min, max: max, step: step}
+	if ie.lastSample != nil && ie.lastSampleKey == key {
+		return ie.lastSample
+	}
+
+	sampled := &SampledAggregate{}
+	for x := min; x <= max; x += step {
+		sampled.X = append(sampled.X, x)
+		sampled.Mu = append(sampled.Mu, aggregate.MembershipDegree(x))
+	}
+	ie.lastSampleKey = key
+	ie.lastSample = sampled
+	return sampled
+}
+
+// Centroid returns the centroid of the sampled aggregate.
+func (s *SampledAggregate) Centroid() float64 {
+	numerator, denominator := 0.0, 0.0
+	for i, x := range s.X {
+		numerator += x * s.Mu[i]
+		denominator += s.Mu[i]
+	}
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+// MOM returns the mean of maximum of the sampled aggregate.
+func (s *SampledAggregate) MOM() float64 {
+	maxMu, sumX, count := 0.0, 0.0, 0.0
+	for i, x := range s.X {
+		mu := s.Mu[i]
+		if mu > maxMu {
+			maxMu = mu
+			sumX = x
+			count = 1
+		} else if mu == maxMu {
+			sumX += x
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return sumX / count
+}
+
+// SOM returns the smallest of maximum of the sampled aggregate: the
+// leftmost sample point attaining the peak membership degree.
+func (s *SampledAggregate) SOM() float64 {
+	if len(s.X) == 0 {
+		return 0
+	}
+	maxMu, som := s.Mu[0], s.X[0]
+	for i, x := range s.X {
+		if s.Mu[i] > maxMu {
+			maxMu = s.Mu[i]
+			som = x
+		}
+	}
+	return som
+}
+
+// LOM returns the largest of maximum of the sampled aggregate: the
+// rightmost sample point attaining the peak membership degree.
+func (s *SampledAggregate) LOM() float64 {
+	if len(s.X) == 0 {
+		return 0
+	}
+	maxMu, lom := s.Mu[0], s.X[0]
+	for i, x := range s.X {
+		if s.Mu[i] >= maxMu {
+			maxMu = s.Mu[i]
+			lom = x
+		}
+	}
+	return lom
+}
+
+// Bisector returns the bisector of the sampled aggregate: the point that
+// splits its area into two equal halves.
+func (s *SampledAggregate) Bisector() float64 {
+	if len(s.X) == 0 {
+		return 0
+	}
+	step := 0.0
+	if len(s.X) > 1 {
+		step = s.X[1] - s.X[0]
+	}
+	totalArea := 0.0
+	for _, mu := range s.Mu {
+		totalArea += mu * step
+	}
+	leftArea := 0.0
+	bisector := s.X[0]
+	for i, mu := range s.Mu {
+		leftArea += mu * step
+		if leftArea >= totalArea/2 {
+			bisector = s.X[i]
+			break
+		}
+	}
+	return bisector
+}
+
+// DefuzzifyCentroid returns the centroid of the aggregate output set.
+func (ie *InferenceEngine) DefuzzifyCentroid(aggregate *FuzzySet, min, max, step float64) float64 {
+	return ie.Sample(aggregate, min, max, step).Centroid()
+}
+
+// DefuzzifyMOM returns the mean of maximum of the aggregate output set.
+func (ie *InferenceEngine) DefuzzifyMOM(aggregate *FuzzySet, min, max, step float64) float64 {
+	return ie.Sample(aggregate, min, max, step).MOM()
+}
+
+// DefuzzifyBisector returns the bisector of the aggregate output set: the
+// point that splits its area into two equal halves.
+func (ie *InferenceEngine) DefuzzifyBisector(aggregate *FuzzySet, min, max, step float64) float64 {
+	return ie.Sample(aggregate, min, max, step).Bisector()
+}
+
+// DefuzzifySOM returns the smallest of maximum of the aggregate output set.
+func (ie *InferenceEngine) DefuzzifySOM(aggregate *FuzzySet, min, max, step float64) float64 {
+	return ie.Sample(aggregate, min, max, step).SOM()
+}
+
+// DefuzzifyLOM returns the largest of maximum of the aggregate output set.
+func (ie *InferenceEngine) DefuzzifyLOM(aggregate *FuzzySet, min, max, step float64) float64 {
+	return ie.Sample(aggregate, min, max, step).LOM()
+}
+
+// DefuzzifyWeightedAverage returns the weighted average of each fired
+// rule's consequent centroid, weighted by firing strength. It is a cheaper
+// approximation of DefuzzifyCentroid that skips aggregating the rules into
+// a combined output set.
+func (ie *InferenceEngine) DefuzzifyWeightedAverage(inputs map[string]float64, min, max, step float64) float64 {
+	weightedSum, totalWeight := 0.0, 0.0
+	for _, rule := range ie.Rules {
+		strength := rule.FiringStrength(inputs, ie.TNorm, ie.SNorm)
+		if strength <= 0 {
+			continue
+		}
+		z := rule.Consequence.Centroid(min, max, step)
+		weightedSum += z * strength
+		totalWeight += strength
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return weightedSum / totalWeight
+}
+
+// DefuzzifyHeight returns the weighted average of each fired rule's peak
+// point, weighted by the implication-clipped height at that point. It is
+// the height method: consequents are approximated by their modal value
+// instead of their full shape.
+func (ie *InferenceEngine) DefuzzifyHeight(inputs map[string]float64, min, max, step float64) float64 {
+	weightedSum, totalWeight := 0.0, 0.0
+	for _, rule := range ie.Rules {
+		strength := rule.FiringStrength(inputs, ie.TNorm, ie.SNorm)
+		if strength <= 0 {
+			continue
+		}
+		peakX, peakMu := min, 0.0
+		for x := min; x <= max; x += step {
+			if mu := rule.Consequence.MembershipDegree(x); mu > peakMu {
+				peakMu = mu
+				peakX = x
+			}
+		}
+		height := ie.Implication(strength, peakMu)
+		weightedSum += peakX * height
+		totalWeight += height
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return weightedSum / totalWeight
+}
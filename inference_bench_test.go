@@ -0,0 +1,59 @@
+package gofuzzymind
+
+import "testing"
+
+// benchRuleBase builds an engine with n consequents firing against a fixed
+// input, used to benchmark the cost of defuzzification.
+func benchRuleBase(n int) (*InferenceEngine, map[string]float64) {
+	input := NewFuzzySet("In", Triangular(0, 50, 100))
+	rules := make([]*Rule, n)
+	for i := 0; i < n; i++ {
+		peak := float64(i) * (100.0 / float64(n))
+		out := NewFuzzySet("Out", Triangular(peak-5, peak, peak+5))
+		rules[i] = NewRule(map[string]*FuzzySet{"x": input}, out, 1)
+	}
+	return NewInferenceEngine(rules), map[string]float64{"x": 50}
+}
+
+// freshSample sweeps aggregate from scratch, bypassing InferenceEngine's
+// memoized last sample, to reproduce the pre-caching cost of each
+// defuzzifier resampling independently.
+func freshSample(aggregate *FuzzySet, min, max, step float64) *SampledAggregate {
+	sampled := &SampledAggregate{}
+	for x := min; x <= max; x += step {
+		sampled.X = append(sampled.X, x)
+		sampled.Mu = append(sampled.Mu, aggregate.MembershipDegree(x))
+	}
+	return sampled
+}
+
+// BenchmarkDefuzzifyResample re-samples the aggregate from scratch for each
+// defuzzifier, as the original implementation did before InferenceEngine
+// memoized the last sample.
+func BenchmarkDefuzzifyResample(b *testing.B) {
+	ie, inputs := benchRuleBase(48)
+	for i := 0; i < b.N; i++ {
+		aggregate := ie.Infer(inputs)
+		_ = freshSample(aggregate, 0, 100, 0.1).Centroid()
+		_ = freshSample(aggregate, 0, 100, 0.1).MOM()
+		_ = freshSample(aggregate, 0, 100, 0.1).Bisector()
+		_ = freshSample(aggregate, 0, 100, 0.1).SOM()
+		_ = freshSample(aggregate, 0, 100, 0.1).LOM()
+	}
+}
+
+// BenchmarkDefuzzifyCachedSample samples the aggregate once and reuses it
+// for every defuzzifier, as DefuzzifyCentroid/MOM/Bisector/SOM/LOM now do
+// internally when called from the same SampledAggregate.
+func BenchmarkDefuzzifyCachedSample(b *testing.B) {
+	ie, inputs := benchRuleBase(48)
+	for i := 0; i < b.N; i++ {
+		aggregate := ie.Infer(inputs)
+		sampled := ie.Sample(aggregate, 0, 100, 0.1)
+		_ = sampled.Centroid()
+		_ = sampled.MOM()
+		_ = sampled.Bisector()
+		_ = sampled.SOM()
+		_ = sampled.LOM()
+	}
+}
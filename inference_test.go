@@ -0,0 +1,108 @@
+package gofuzzymind
+
+import "testing"
+
+func TestInferenceEngineMamdaniCentroid(t *testing.T) {
+	input := NewFuzzyVariable("x", NewCrispSet(0, 10, 101), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", StepDown(0, 5)),
+		"High": NewFuzzySet("High", StepUp(5, 10)),
+	})
+	output := NewFuzzyVariable("y", NewCrispSet(-10, 20, 301), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", Triangular(-1, 0, 1)),
+		"High": NewFuzzySet("High", Triangular(9, 10, 11)),
+	})
+
+	rules := []*Rule{
+		NewRule(map[string]*FuzzySet{"x": input.Set("Low")}, output.Set("Low"), 1),
+		NewRule(map[string]*FuzzySet{"x": input.Set("High")}, output.Set("High"), 1),
+	}
+	engine := NewInferenceEngine(rules)
+
+	// x=0 fully matches "Low", so the aggregate should be centered near 0.
+	aggregate := engine.Infer(map[string]float64{"x": 0})
+	if got := engine.DefuzzifyCentroid(aggregate, -10, 20, 0.01); !almostEqual(got, 0, 0.2) {
+		t.Errorf("DefuzzifyCentroid at x=0 = %v, want ~0", got)
+	}
+
+	// x=10 fully matches "High", so the aggregate should be centered near 10.
+	aggregate = engine.Infer(map[string]float64{"x": 10})
+	if got := engine.DefuzzifyCentroid(aggregate, -10, 20, 0.01); !almostEqual(got, 10, 0.2) {
+		t.Errorf("DefuzzifyCentroid at x=10 = %v, want ~10", got)
+	}
+}
+
+// almostEqual reports whether a and b differ by no more than tolerance,
+// shared by the tests in this package.
+func almostEqual(a, b, tolerance float64) bool {
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d <= tolerance
+}
+
+func TestInferenceEngineNoRuleFires(t *testing.T) {
+	input := NewFuzzySet("In", StepUp(50, 60))
+	output := NewFuzzySet("Out", Triangular(0, 5, 10))
+	rule := NewRule(map[string]*FuzzySet{"x": input}, output, 1)
+	engine := NewInferenceEngine([]*Rule{rule})
+
+	aggregate := engine.Infer(map[string]float64{"x": 0})
+	if got := engine.DefuzzifyCentroid(aggregate, 0, 10, 0.1); got != 0 {
+		t.Errorf("DefuzzifyCentroid with no rule fired = %v, want 0", got)
+	}
+}
+
+func TestImplicationMethods(t *testing.T) {
+	if got := MamdaniMin(0.5, 0.8); got != 0.5 {
+		t.Errorf("MamdaniMin(0.5,0.8) = %v, want 0.5", got)
+	}
+	if got := LarsenProduct(0.5, 0.8); !almostEqual(got, 0.4, 1e-9) {
+		t.Errorf("LarsenProduct(0.5,0.8) = %v, want 0.4", got)
+	}
+}
+
+func TestRuleFiringStrengthTNorm(t *testing.T) {
+	setA := NewFuzzySet("A", func(float64) float64 { return 0.4 })
+	setB := NewFuzzySet("B", func(float64) float64 { return 0.9 })
+	rule := NewRule(map[string]*FuzzySet{"a": setA, "b": setB}, setA, 1)
+
+	if got := rule.FiringStrength(map[string]float64{"a": 0, "b": 0}, MinTNorm, MaxSNorm); !almostEqual(got, 0.4, 1e-9) {
+		t.Errorf("FiringStrength with MinTNorm = %v, want 0.4", got)
+	}
+	if got := rule.FiringStrength(map[string]float64{"a": 0, "b": 0}, ProductTNorm, MaxSNorm); !almostEqual(got, 0.36, 1e-9) {
+		t.Errorf("FiringStrength with ProductTNorm = %v, want 0.36", got)
+	}
+}
+
+func TestSampledAggregateDefuzzifiers(t *testing.T) {
+	// A flat-topped set at height 1 from 4..6, sloping to 0 at 3 and 7.
+	flat := NewFuzzySet("Flat", Trapezoid(3, 4, 6, 7))
+	engine := NewInferenceEngine(nil)
+	sampled := engine.Sample(flat, 0, 10, 0.5)
+
+	if got := sampled.Centroid(); !almostEqual(got, 5, 0.2) {
+		t.Errorf("Centroid = %v, want ~5", got)
+	}
+	if got := sampled.Bisector(); !almostEqual(got, 5, 0.5) {
+		t.Errorf("Bisector = %v, want ~5", got)
+	}
+	if som, lom := sampled.SOM(), sampled.LOM(); som > lom {
+		t.Errorf("SOM (%v) should not be greater than LOM (%v)", som, lom)
+	}
+}
+
+func TestDefuzzifyWeightedAverageAndHeight(t *testing.T) {
+	input := NewFuzzySet("In", func(float64) float64 { return 1 })
+	out := NewFuzzySet("Out", Triangular(4, 5, 6))
+	rule := NewRule(map[string]*FuzzySet{"x": input}, out, 1)
+	engine := NewInferenceEngine([]*Rule{rule})
+
+	inputs := map[string]float64{"x": 0}
+	if got := engine.DefuzzifyWeightedAverage(inputs, 0, 10, 0.1); !almostEqual(got, 5, 0.2) {
+		t.Errorf("DefuzzifyWeightedAverage = %v, want ~5", got)
+	}
+	if got := engine.DefuzzifyHeight(inputs, 0, 10, 0.1); !almostEqual(got, 5, 0.2) {
+		t.Errorf("DefuzzifyHeight = %v, want ~5", got)
+	}
+}
@@ -0,0 +1,145 @@
+package gofuzzymind
+
+import "math"
+
+// Triangular returns a triangular membership function that rises linearly
+// from 0 at a to 1 at b, then falls linearly from 1 at b to 0 at c.
+func Triangular(a, b, c float64) func(float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x <= a || x >= c:
+			return 0
+		case x < b:
+			return (x - a) / (b - a)
+		case x > b:
+			return (c - x) / (c - b)
+		default:
+			return 1
+		}
+	}
+}
+
+// Trapezoid returns a trapezoidal membership function that rises linearly
+// from 0 at a to 1 at b, stays at 1 until c, then falls linearly to 0 at d.
+func Trapezoid(a, b, c, d float64) func(float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x <= a || x >= d:
+			return 0
+		case x < b:
+			return (x - a) / (b - a)
+		case x <= c:
+			return 1
+		default:
+			return (d - x) / (d - c)
+		}
+	}
+}
+
+// StepUp returns a membership function that rises linearly from 0 at a to
+// 1 at b, and stays at 1 beyond b.
+func StepUp(a, b float64) func(float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x <= a:
+			return 0
+		case x >= b:
+			return 1
+		default:
+			return (x - a) / (b - a)
+		}
+	}
+}
+
+// StepDown returns a membership function that falls linearly from 1 at a to
+// 0 at b, and stays at 0 beyond b.
+func StepDown(a, b float64) func(float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x <= a:
+			return 1
+		case x >= b:
+			return 0
+		default:
+			return (b - x) / (b - a)
+		}
+	}
+}
+
+// Gaussian returns a Gaussian (bell-shaped) membership function centered on
+// mean with the given standard deviation sigma.
+func Gaussian(mean, sigma float64) func(float64) float64 {
+	return func(x float64) float64 {
+		d := (x - mean) / sigma
+		return math.Exp(-0.5 * d * d)
+	}
+}
+
+// Sigmoid returns a logistic membership function with slope a and
+// inflection point c.
+func Sigmoid(a, c float64) func(float64) float64 {
+	return func(x float64) float64 {
+		return 1 / (1 + math.Exp(-a*(x-c)))
+	}
+}
+
+// CrispSet describes a sampled crisp universe of discourse: N evenly spaced
+// points between Min and Max, inclusive.
+type CrispSet struct {
+	Min, Max float64
+	N        int
+}
+
+// NewCrispSet creates a new CrispSet.
+func NewCrispSet(min, max float64, n int) CrispSet {
+	return CrispSet{Min: min, Max: max, N: n}
+}
+
+// Samples returns the precomputed sample points of the universe.
+func (cs CrispSet) Samples() []float64 {
+	if cs.N <= 1 {
+		return []float64{cs.Min}
+	}
+	points := make([]float64, cs.N)
+	step := (cs.Max - cs.Min) / float64(cs.N-1)
+	for i := range points {
+		points[i] = cs.Min + step*float64(i)
+	}
+	return points
+}
+
+// Step returns the spacing between consecutive samples of the universe.
+func (cs CrispSet) Step() float64 {
+	if cs.N <= 1 {
+		return cs.Max - cs.Min
+	}
+	return (cs.Max - cs.Min) / float64(cs.N-1)
+}
+
+// FuzzyVariable is a named crisp universe together with the named FuzzySets
+// defined over it, e.g. "HP" over [0,100] with sets "Low", "Medium", "High".
+type FuzzyVariable struct {
+	Name     string
+	Universe CrispSet
+	Sets     map[string]*FuzzySet
+}
+
+// NewFuzzyVariable creates a new FuzzyVariable over the given universe.
+func NewFuzzyVariable(name string, universe CrispSet, sets map[string]*FuzzySet) *FuzzyVariable {
+	return &FuzzyVariable{Name: name, Universe: universe, Sets: sets}
+}
+
+// Set returns the named FuzzySet, or nil if the variable has no such set.
+func (fv *FuzzyVariable) Set(name string) *FuzzySet {
+	return fv.Sets[name]
+}
+
+// MembershipDegrees returns the membership degree of x in every set of the
+// variable, keyed by set name.
+func (fv *FuzzyVariable) MembershipDegrees(x float64) map[string]float64 {
+	degrees := make(map[string]float64, len(fv.Sets))
+	for name, set := range fv.Sets {
+		degrees[name] = set.MembershipDegree(x)
+	}
+	return degrees
+}
@@ -0,0 +1,110 @@
+package gofuzzymind
+
+import (
+	"testing"
+)
+
+func TestTriangular(t *testing.T) {
+	mf := Triangular(0, 10, 20)
+	cases := map[float64]float64{
+		-5: 0,
+		0:  0,
+		5:  0.5,
+		10: 1,
+		15: 0.5,
+		20: 0,
+		25: 0,
+	}
+	for x, want := range cases {
+		if got := mf(x); !almostEqual(got, want, 1e-9) {
+			t.Errorf("Triangular(0,10,20)(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestTrapezoid(t *testing.T) {
+	mf := Trapezoid(0, 10, 20, 30)
+	cases := map[float64]float64{
+		-5: 0,
+		5:  0.5,
+		15: 1,
+		25: 0.5,
+		35: 0,
+	}
+	for x, want := range cases {
+		if got := mf(x); !almostEqual(got, want, 1e-9) {
+			t.Errorf("Trapezoid(0,10,20,30)(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestStepUpStepDown(t *testing.T) {
+	up := StepUp(0, 10)
+	down := StepDown(0, 10)
+	for _, x := range []float64{-5, 0, 5, 10, 15} {
+		if got, want := up(x), 1-down(x); !almostEqual(got, want, 1e-9) {
+			t.Errorf("StepUp(%v) = %v, want complement of StepDown = %v", x, got, want)
+		}
+	}
+	if got := up(-5); got != 0 {
+		t.Errorf("StepUp below a = %v, want 0", got)
+	}
+	if got := up(20); got != 1 {
+		t.Errorf("StepUp above b = %v, want 1", got)
+	}
+}
+
+func TestGaussian(t *testing.T) {
+	mf := Gaussian(0, 1)
+	if got := mf(0); !almostEqual(got, 1, 1e-9) {
+		t.Errorf("Gaussian peak = %v, want 1", got)
+	}
+	if got := mf(1); got <= 0 || got >= 1 {
+		t.Errorf("Gaussian(1) = %v, want in (0,1)", got)
+	}
+}
+
+func TestSigmoid(t *testing.T) {
+	mf := Sigmoid(1, 0)
+	if got := mf(0); !almostEqual(got, 0.5, 1e-9) {
+		t.Errorf("Sigmoid inflection = %v, want 0.5", got)
+	}
+	if mf(10) <= mf(0) {
+		t.Errorf("Sigmoid should be increasing for positive slope")
+	}
+}
+
+func TestCrispSetSamples(t *testing.T) {
+	cs := NewCrispSet(0, 10, 5)
+	samples := cs.Samples()
+	if len(samples) != 5 {
+		t.Fatalf("got %d samples, want 5", len(samples))
+	}
+	if samples[0] != 0 || samples[len(samples)-1] != 10 {
+		t.Errorf("samples should span [0,10], got %v", samples)
+	}
+	if got, want := cs.Step(), 2.5; !almostEqual(got, want, 1e-9) {
+		t.Errorf("Step() = %v, want %v", got, want)
+	}
+}
+
+func TestFuzzyVariable(t *testing.T) {
+	low := NewFuzzySet("Low", StepDown(0, 50))
+	high := NewFuzzySet("High", StepUp(50, 100))
+	hp := NewFuzzyVariable("HP", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Low":  low,
+		"High": high,
+	})
+
+	if hp.Set("Low") != low {
+		t.Errorf("Set(Low) did not return the Low FuzzySet")
+	}
+	if hp.Set("Missing") != nil {
+		t.Errorf("Set(Missing) should be nil")
+	}
+
+	degrees := hp.MembershipDegrees(25)
+	if !almostEqual(degrees["Low"], 0.5, 1e-9) {
+		t.Errorf("degrees[Low] = %v, want 0.5", degrees["Low"])
+	}
+}
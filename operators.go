@@ -0,0 +1,79 @@
+package gofuzzymind
+
+import "math"
+
+// Negation maps a membership degree to its negated membership degree,
+// modeling fuzzy NOT.
+type Negation func(mu float64) float64
+
+// StandardNegation is the classical Zadeh negation: 1-mu.
+var StandardNegation Negation = func(mu float64) float64 { return 1 - mu }
+
+// SugenoNegation returns the Sugeno-parameterized negation with parameter
+// lambda > -1: (1-mu) / (1+lambda*mu). lambda=0 reduces to StandardNegation.
+func SugenoNegation(lambda float64) Negation {
+	return func(mu float64) float64 {
+		return (1 - mu) / (1 + lambda*mu)
+	}
+}
+
+// YagerNegation returns the Yager-parameterized negation with parameter
+// w > 0: (1-mu^w)^(1/w). w=1 reduces to StandardNegation.
+func YagerNegation(w float64) Negation {
+	return func(mu float64) float64 {
+		return math.Pow(1-math.Pow(mu, w), 1/w)
+	}
+}
+
+// ProbabilisticTNorm is the algebraic product T-norm: a*b. It is the same
+// operator as ProductTNorm, named for its probabilistic (independent
+// events) interpretation.
+var ProbabilisticTNorm = ProductTNorm
+
+// ProbabilisticSNorm is the algebraic sum S-norm, the probabilistic
+// conjugate of ProbabilisticTNorm: a+b-a*b.
+var ProbabilisticSNorm SNorm = func(a, b float64) float64 { return a + b - a*b }
+
+// LukasiewiczTNorm is the Łukasiewicz T-norm: max(0, a+b-1).
+var LukasiewiczTNorm TNorm = func(a, b float64) float64 { return math.Max(0, a+b-1) }
+
+// LukasiewiczSNorm is the Łukasiewicz S-norm, the bounded sum min(1, a+b).
+// It is the same operator as BoundedSumSNorm.
+var LukasiewiczSNorm = BoundedSumSNorm
+
+// EinsteinTNorm is the Einstein product T-norm: a*b / (2-(a+b-a*b)).
+var EinsteinTNorm TNorm = func(a, b float64) float64 {
+	return (a * b) / (2 - (a + b - a*b))
+}
+
+// EinsteinSNorm is the Einstein sum S-norm: (a+b) / (1+a*b).
+var EinsteinSNorm SNorm = func(a, b float64) float64 {
+	return (a + b) / (1 + a*b)
+}
+
+// HamacherTNorm returns the Hamacher T-norm parameterized by gamma >= 0:
+// a*b / (gamma + (1-gamma)*(a+b-a*b)). gamma=1 reduces to the algebraic
+// product, gamma=0 is the Hamacher product.
+func HamacherTNorm(gamma float64) TNorm {
+	return func(a, b float64) float64 {
+		denom := gamma + (1-gamma)*(a+b-a*b)
+		if denom == 0 {
+			return 0
+		}
+		return (a * b) / denom
+	}
+}
+
+// HamacherSNorm returns the Hamacher S-norm parameterized by gamma >= 0,
+// the conjugate of HamacherTNorm: (a+b-(2-gamma)*a*b) / (1-(1-gamma)*a*b).
+func HamacherSNorm(gamma float64) SNorm {
+	return func(a, b float64) float64 {
+		denom := 1 - (1-gamma)*a*b
+		if denom == 0 {
+			// Only reached at a=b=1 with gamma=0, where the conjugate of
+			// HamacherTNorm (which returns 0 at a=b=0) is 1.
+			return 1
+		}
+		return (a + b - (2-gamma)*a*b) / denom
+	}
+}
@@ -0,0 +1,100 @@
+package gofuzzymind
+
+import "testing"
+
+func TestZadehOperators(t *testing.T) {
+	if got := MinTNorm(0.3, 0.7); !almostEqual(got, 0.3, 1e-9) {
+		t.Errorf("MinTNorm(0.3,0.7) = %v, want 0.3", got)
+	}
+	if got := MaxSNorm(0.3, 0.7); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("MaxSNorm(0.3,0.7) = %v, want 0.7", got)
+	}
+}
+
+func TestProbabilisticOperators(t *testing.T) {
+	if got := ProbabilisticTNorm(0.5, 0.5); !almostEqual(got, 0.25, 1e-9) {
+		t.Errorf("ProbabilisticTNorm(0.5,0.5) = %v, want 0.25", got)
+	}
+	if got := ProbabilisticSNorm(0.5, 0.5); !almostEqual(got, 0.75, 1e-9) {
+		t.Errorf("ProbabilisticSNorm(0.5,0.5) = %v, want 0.75", got)
+	}
+}
+
+func TestLukasiewiczOperators(t *testing.T) {
+	if got := LukasiewiczTNorm(0.3, 0.3); !almostEqual(got, 0, 1e-9) {
+		t.Errorf("LukasiewiczTNorm(0.3,0.3) = %v, want 0", got)
+	}
+	if got := LukasiewiczTNorm(0.7, 0.7); !almostEqual(got, 0.4, 1e-9) {
+		t.Errorf("LukasiewiczTNorm(0.7,0.7) = %v, want 0.4", got)
+	}
+	if got := LukasiewiczSNorm(0.7, 0.7); !almostEqual(got, 1, 1e-9) {
+		t.Errorf("LukasiewiczSNorm(0.7,0.7) = %v, want 1", got)
+	}
+}
+
+func TestEinsteinOperators(t *testing.T) {
+	if got := EinsteinTNorm(0, 0.5); !almostEqual(got, 0, 1e-9) {
+		t.Errorf("EinsteinTNorm(0,0.5) = %v, want 0", got)
+	}
+	if got := EinsteinSNorm(0, 0.5); !almostEqual(got, 0.5, 1e-9) {
+		t.Errorf("EinsteinSNorm(0,0.5) = %v, want 0.5", got)
+	}
+}
+
+func TestHamacherOperators(t *testing.T) {
+	// gamma=1 reduces Hamacher to the algebraic product/sum.
+	tnorm := HamacherTNorm(1)
+	if got := tnorm(0.5, 0.5); !almostEqual(got, 0.25, 1e-9) {
+		t.Errorf("HamacherTNorm(1)(0.5,0.5) = %v, want 0.25", got)
+	}
+	snorm := HamacherSNorm(1)
+	if got := snorm(0.5, 0.5); !almostEqual(got, 0.75, 1e-9) {
+		t.Errorf("HamacherSNorm(1)(0.5,0.5) = %v, want 0.75", got)
+	}
+}
+
+func TestNegations(t *testing.T) {
+	if got := StandardNegation(0.3); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("StandardNegation(0.3) = %v, want 0.7", got)
+	}
+	sugeno := SugenoNegation(0)
+	if got := sugeno(0.3); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("SugenoNegation(0)(0.3) = %v, want 0.7 (lambda=0 reduces to standard negation)", got)
+	}
+	yager := YagerNegation(1)
+	if got := yager(0.3); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("YagerNegation(1)(0.3) = %v, want 0.7 (w=1 reduces to standard negation)", got)
+	}
+}
+
+func TestHedges(t *testing.T) {
+	set := NewFuzzySet("Mid", func(float64) float64 { return 0.5 })
+
+	if got := set.Very().MembershipDegree(0); !almostEqual(got, 0.25, 1e-9) {
+		t.Errorf("Very() = %v, want 0.25", got)
+	}
+	if got := set.Extremely().MembershipDegree(0); !almostEqual(got, 0.125, 1e-9) {
+		t.Errorf("Extremely() = %v, want 0.125", got)
+	}
+	if got := set.Somewhat().MembershipDegree(0); !almostEqual(got, 0.7071, 1e-3) {
+		t.Errorf("Somewhat() = %v, want ~0.7071", got)
+	}
+	if got := set.Not().MembershipDegree(0); !almostEqual(got, 0.5, 1e-9) {
+		t.Errorf("Not() = %v, want 0.5 (1-0.5)", got)
+	}
+}
+
+func TestUnionIntersectionComplementAcceptOperators(t *testing.T) {
+	a := NewFuzzySet("A", func(float64) float64 { return 0.3 })
+	b := NewFuzzySet("B", func(float64) float64 { return 0.7 })
+
+	if got := a.Union(b, MaxSNorm).MembershipDegree(0); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("Union with MaxSNorm = %v, want 0.7", got)
+	}
+	if got := a.Intersection(b, MinTNorm).MembershipDegree(0); !almostEqual(got, 0.3, 1e-9) {
+		t.Errorf("Intersection with MinTNorm = %v, want 0.3", got)
+	}
+	if got := a.Complement(StandardNegation).MembershipDegree(0); !almostEqual(got, 0.7, 1e-9) {
+		t.Errorf("Complement with StandardNegation = %v, want 0.7", got)
+	}
+}
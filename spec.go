@@ -0,0 +1,240 @@
+package gofuzzymind
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MembershipSpec describes a membership function by kind and parameters,
+// so it can be marshaled to and from JSON/YAML. Params are interpreted
+// positionally per kind: "triangular" (a,b,c), "trapezoid" (a,b,c,d),
+// "stepup"/"stepdown" (a,b), "gaussian" (mean,sigma), "sigmoid" (a,c).
+type MembershipSpec struct {
+	Kind   string    `json:"kind" yaml:"kind"`
+	Params []float64 `json:"params" yaml:"params"`
+}
+
+// Build returns the membership function described by the spec.
+func (m MembershipSpec) Build() (func(float64) float64, error) {
+	p := m.Params
+	switch m.Kind {
+	case "triangular":
+		if len(p) != 3 {
+			return nil, fmt.Errorf("gofuzzymind: triangular membership needs 3 params, got %d", len(p))
+		}
+		return Triangular(p[0], p[1], p[2]), nil
+	case "trapezoid":
+		if len(p) != 4 {
+			return nil, fmt.Errorf("gofuzzymind: trapezoid membership needs 4 params, got %d", len(p))
+		}
+		return Trapezoid(p[0], p[1], p[2], p[3]), nil
+	case "stepup":
+		if len(p) != 2 {
+			return nil, fmt.Errorf("gofuzzymind: stepup membership needs 2 params, got %d", len(p))
+		}
+		return StepUp(p[0], p[1]), nil
+	case "stepdown":
+		if len(p) != 2 {
+			return nil, fmt.Errorf("gofuzzymind: stepdown membership needs 2 params, got %d", len(p))
+		}
+		return StepDown(p[0], p[1]), nil
+	case "gaussian":
+		if len(p) != 2 {
+			return nil, fmt.Errorf("gofuzzymind: gaussian membership needs 2 params, got %d", len(p))
+		}
+		return Gaussian(p[0], p[1]), nil
+	case "sigmoid":
+		if len(p) != 2 {
+			return nil, fmt.Errorf("gofuzzymind: sigmoid membership needs 2 params, got %d", len(p))
+		}
+		return Sigmoid(p[0], p[1]), nil
+	default:
+		return nil, fmt.Errorf("gofuzzymind: unknown membership kind %q", m.Kind)
+	}
+}
+
+// FuzzySetSpec is the declarative form of a single named FuzzySet within a
+// FuzzyVariable.
+type FuzzySetSpec struct {
+	Name       string         `json:"name" yaml:"name"`
+	Membership MembershipSpec `json:"membership" yaml:"membership"`
+}
+
+// VariableSpec is the declarative form of a FuzzyVariable: a named crisp
+// universe plus the FuzzySets defined over it.
+type VariableSpec struct {
+	Name string         `json:"name" yaml:"name"`
+	Min  float64        `json:"min" yaml:"min"`
+	Max  float64        `json:"max" yaml:"max"`
+	N    int            `json:"n" yaml:"n"`
+	Sets []FuzzySetSpec `json:"sets" yaml:"sets"`
+}
+
+// Build returns the FuzzyVariable described by the spec.
+func (v VariableSpec) Build() (*FuzzyVariable, error) {
+	sets := make(map[string]*FuzzySet, len(v.Sets))
+	for _, s := range v.Sets {
+		fn, err := s.Membership.Build()
+		if err != nil {
+			return nil, fmt.Errorf("gofuzzymind: variable %q set %q: %w", v.Name, s.Name, err)
+		}
+		sets[s.Name] = NewFuzzySet(s.Name, fn)
+	}
+	return NewFuzzyVariable(v.Name, NewCrispSet(v.Min, v.Max, v.N), sets), nil
+}
+
+// RuleSpec is the declarative form of a Rule: an antecedent expression
+// (e.g. "HP is Low AND FP is High") and the name of the output set it
+// recommends when it fires. Weight is a pointer so an omitted weight
+// (nil, defaulting to 1) can be told apart from an explicit weight of 0.
+type RuleSpec struct {
+	Antecedent string   `json:"antecedent" yaml:"antecedent"`
+	Output     string   `json:"output" yaml:"output"`
+	Weight     *float64 `json:"weight,omitempty" yaml:"weight,omitempty"`
+}
+
+// Spec is the declarative form of an entire Mamdani fuzzy system: its input
+// variables, its output variable, and its rule base. It can be marshaled
+// to and from JSON or YAML so rule bases can be edited and versioned as
+// data rather than Go code.
+type Spec struct {
+	Inputs      []VariableSpec `json:"inputs" yaml:"inputs"`
+	Output      VariableSpec   `json:"output" yaml:"output"`
+	Rules       []RuleSpec     `json:"rules" yaml:"rules"`
+	TNorm       string         `json:"tnorm,omitempty" yaml:"tnorm,omitempty"`
+	SNorm       string         `json:"snorm,omitempty" yaml:"snorm,omitempty"`
+	Implication string         `json:"implication,omitempty" yaml:"implication,omitempty"`
+}
+
+// ToJSON marshals the spec to JSON.
+func (s *Spec) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// ToYAML marshals the spec to YAML.
+func (s *Spec) ToYAML() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+func namedTNorm(name string) (TNorm, error) {
+	switch name {
+	case "", "min", "zadeh":
+		return MinTNorm, nil
+	case "product", "probabilistic":
+		return ProductTNorm, nil
+	case "lukasiewicz":
+		return LukasiewiczTNorm, nil
+	case "einstein":
+		return EinsteinTNorm, nil
+	default:
+		return nil, fmt.Errorf("gofuzzymind: unknown tnorm %q", name)
+	}
+}
+
+func namedSNorm(name string) (SNorm, error) {
+	switch name {
+	case "", "max", "zadeh":
+		return MaxSNorm, nil
+	case "boundedsum", "lukasiewicz":
+		return BoundedSumSNorm, nil
+	case "probabilistic":
+		return ProbabilisticSNorm, nil
+	case "einstein":
+		return EinsteinSNorm, nil
+	default:
+		return nil, fmt.Errorf("gofuzzymind: unknown snorm %q", name)
+	}
+}
+
+func namedImplication(name string) (Implication, error) {
+	switch name {
+	case "", "mamdani", "min":
+		return MamdaniMin, nil
+	case "larsen", "product":
+		return LarsenProduct, nil
+	default:
+		return nil, fmt.Errorf("gofuzzymind: unknown implication %q", name)
+	}
+}
+
+// buildEngine constructs an InferenceEngine from the spec, parsing each
+// rule's antecedent expression against the declared input variables.
+func (s *Spec) buildEngine() (*InferenceEngine, error) {
+	variables := make(map[string]*FuzzyVariable, len(s.Inputs))
+	for _, in := range s.Inputs {
+		variable, err := in.Build()
+		if err != nil {
+			return nil, err
+		}
+		variables[in.Name] = variable
+	}
+	output, err := s.Output.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*Rule, 0, len(s.Rules))
+	for _, rs := range s.Rules {
+		expr, err := ParseAntecedent(rs.Antecedent, variables)
+		if err != nil {
+			return nil, err
+		}
+		outSet := output.Set(rs.Output)
+		if outSet == nil {
+			return nil, fmt.Errorf("gofuzzymind: rule output %q is not a set of output variable %q", rs.Output, output.Name)
+		}
+		weight := 1.0
+		if rs.Weight != nil {
+			weight = *rs.Weight
+		}
+		rules = append(rules, NewExprRule(expr, outSet, weight))
+	}
+
+	tnorm, err := namedTNorm(s.TNorm)
+	if err != nil {
+		return nil, err
+	}
+	snorm, err := namedSNorm(s.SNorm)
+	if err != nil {
+		return nil, err
+	}
+	implication, err := namedImplication(s.Implication)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InferenceEngine{
+		Rules:       rules,
+		TNorm:       tnorm,
+		SNorm:       snorm,
+		Implication: implication,
+		spec:        s,
+	}, nil
+}
+
+// LoadSpec reads a Spec as JSON or YAML from r and builds the
+// InferenceEngine it describes.
+func LoadSpec(r io.Reader) (*InferenceEngine, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		if yamlErr := yaml.Unmarshal(data, &spec); yamlErr != nil {
+			return nil, fmt.Errorf("gofuzzymind: spec is neither valid JSON (%v) nor valid YAML (%v)", err, yamlErr)
+		}
+	}
+	return spec.buildEngine()
+}
+
+// Spec returns the declarative Spec this engine was built from via
+// LoadSpec, or nil if the engine was assembled directly through Go code
+// rather than loaded from a Spec.
+func (ie *InferenceEngine) Spec() *Spec {
+	return ie.spec
+}
@@ -0,0 +1,173 @@
+package gofuzzymind
+
+import (
+	"strings"
+	"testing"
+)
+
+func specFixtureVariables() map[string]*FuzzyVariable {
+	hp := NewFuzzyVariable("HP", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", StepDown(0, 50)),
+		"High": NewFuzzySet("High", StepUp(50, 100)),
+	})
+	fp := NewFuzzyVariable("FP", NewCrispSet(0, 100, 101), map[string]*FuzzySet{
+		"Low":  NewFuzzySet("Low", StepDown(0, 50)),
+		"High": NewFuzzySet("High", StepUp(50, 100)),
+	})
+	return map[string]*FuzzyVariable{"HP": hp, "FP": fp}
+}
+
+func TestParseAntecedentAnd(t *testing.T) {
+	vars := specFixtureVariables()
+	expr, err := ParseAntecedent("HP is Low AND FP is High", vars)
+	if err != nil {
+		t.Fatalf("ParseAntecedent returned error: %v", err)
+	}
+	got := expr.Eval(map[string]float64{"HP": 0, "FP": 100}, MinTNorm, MaxSNorm, StandardNegation)
+	if !almostEqual(got, 1, 1e-9) {
+		t.Errorf("AND expr eval = %v, want 1", got)
+	}
+}
+
+func TestParseAntecedentOrAndParens(t *testing.T) {
+	vars := specFixtureVariables()
+	expr, err := ParseAntecedent("NOT (HP is Low OR FP is Low)", vars)
+	if err != nil {
+		t.Fatalf("ParseAntecedent returned error: %v", err)
+	}
+	// HP=100, FP=100: both "Low" atoms are 0, OR is 0, NOT(0) is 1.
+	got := expr.Eval(map[string]float64{"HP": 100, "FP": 100}, MinTNorm, MaxSNorm, StandardNegation)
+	if !almostEqual(got, 1, 1e-9) {
+		t.Errorf("NOT/OR/parens expr eval = %v, want 1", got)
+	}
+}
+
+func TestParseAntecedentErrors(t *testing.T) {
+	vars := specFixtureVariables()
+	cases := []string{
+		"Unknown is Low",
+		"HP is Unknown",
+		"HP is Low AND",
+		"(HP is Low",
+		"HP Low",
+	}
+	for _, expr := range cases {
+		if _, err := ParseAntecedent(expr, vars); err == nil {
+			t.Errorf("ParseAntecedent(%q) should have returned an error", expr)
+		}
+	}
+}
+
+func testSpec() *Spec {
+	zero := 0.0
+	return &Spec{
+		Inputs: []VariableSpec{
+			{
+				Name: "HP", Min: 0, Max: 100, N: 101,
+				Sets: []FuzzySetSpec{
+					{Name: "Low", Membership: MembershipSpec{Kind: "stepdown", Params: []float64{0, 50}}},
+					{Name: "High", Membership: MembershipSpec{Kind: "stepup", Params: []float64{50, 100}}},
+				},
+			},
+		},
+		Output: VariableSpec{
+			Name: "Action", Min: 0, Max: 100, N: 101,
+			Sets: []FuzzySetSpec{
+				{Name: "Retreat", Membership: MembershipSpec{Kind: "stepdown", Params: []float64{0, 50}}},
+				{Name: "Attack", Membership: MembershipSpec{Kind: "stepup", Params: []float64{50, 100}}},
+			},
+		},
+		Rules: []RuleSpec{
+			{Antecedent: "HP is Low", Output: "Retreat", Weight: &zero},
+			{Antecedent: "HP is High", Output: "Attack"},
+		},
+	}
+}
+
+func TestLoadSpecJSON(t *testing.T) {
+	spec := testSpec()
+	data, err := spec.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON returned error: %v", err)
+	}
+
+	engine, err := LoadSpec(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("LoadSpec(JSON) returned error: %v", err)
+	}
+	if len(engine.Rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(engine.Rules))
+	}
+	// The first rule explicitly sets weight: 0, which must not be
+	// silently upgraded to the default weight of 1.
+	if got := engine.Rules[0].Weight; got != 0 {
+		t.Errorf("rules[0].Weight = %v, want 0 (explicit zero weight must survive the round trip)", got)
+	}
+	if got := engine.Rules[1].Weight; got != 1 {
+		t.Errorf("rules[1].Weight = %v, want 1 (default for an omitted weight)", got)
+	}
+}
+
+func TestLoadSpecYAML(t *testing.T) {
+	yamlDoc := `
+inputs:
+  - name: HP
+    min: 0
+    max: 100
+    n: 101
+    sets:
+      - name: Low
+        membership: { kind: stepdown, params: [0, 50] }
+      - name: High
+        membership: { kind: stepup, params: [50, 100] }
+output:
+  name: Action
+  min: 0
+  max: 100
+  n: 101
+  sets:
+    - name: Retreat
+      membership: { kind: stepdown, params: [0, 50] }
+    - name: Attack
+      membership: { kind: stepup, params: [50, 100] }
+rules:
+  - antecedent: "HP is Low"
+    output: Retreat
+  - antecedent: "HP is High"
+    output: Attack
+`
+	engine, err := LoadSpec(strings.NewReader(yamlDoc))
+	if err != nil {
+		t.Fatalf("LoadSpec(YAML) returned error: %v", err)
+	}
+
+	aggregate := engine.Infer(map[string]float64{"HP": 0})
+	if got := engine.DefuzzifyCentroid(aggregate, 0, 100, 1); got >= 50 {
+		t.Errorf("DefuzzifyCentroid for HP=0 = %v, want < 50 (should recommend Retreat)", got)
+	}
+}
+
+func TestInferenceEngineSpecRoundTrip(t *testing.T) {
+	spec := testSpec()
+	data, err := spec.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON returned error: %v", err)
+	}
+	engine, err := LoadSpec(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("LoadSpec returned error: %v", err)
+	}
+	if engine.Spec() == nil {
+		t.Fatal("Spec() returned nil for an engine built via LoadSpec")
+	}
+	if len(engine.Spec().Rules) != len(spec.Rules) {
+		t.Errorf("Spec().Rules has %d entries, want %d", len(engine.Spec().Rules), len(spec.Rules))
+	}
+}
+
+func TestInferenceEngineSpecNilWhenNotLoaded(t *testing.T) {
+	engine := NewInferenceEngine(nil)
+	if engine.Spec() != nil {
+		t.Error("Spec() should be nil for an engine assembled directly through Go code")
+	}
+}
@@ -0,0 +1,56 @@
+package gofuzzymind
+
+// SugenoRule is a Takagi-Sugeno-Kang rule: its antecedents map input
+// variable names to the FuzzySet each input is tested against, and its
+// output computes a crisp value from the crisp inputs directly (a constant
+// for zero-order rules, an affine combination for first-order rules).
+type SugenoRule struct {
+	Antecedents map[string]*FuzzySet
+	Output      func(inputs map[string]float64) float64
+	Weight      float64
+}
+
+// NewSugenoRule creates a new SugenoRule. Weight scales the rule's firing
+// strength; pass 1 for an unweighted rule (a weight of 0 is kept as is, so
+// such a rule never fires).
+func NewSugenoRule(antecedents map[string]*FuzzySet, output func(map[string]float64) float64, weight float64) *SugenoRule {
+	return &SugenoRule{Antecedents: antecedents, Output: output, Weight: weight}
+}
+
+// FiringStrength returns how strongly the rule's antecedents match inputs,
+// combining the per-variable membership degrees with tnorm.
+func (r *SugenoRule) FiringStrength(inputs map[string]float64, tnorm TNorm) float64 {
+	return antecedentsFiringStrength(r.Antecedents, inputs, tnorm) * r.Weight
+}
+
+// SugenoEngine runs Takagi-Sugeno-Kang inference over a set of SugenoRules.
+type SugenoEngine struct {
+	Rules []*SugenoRule
+	TNorm TNorm
+}
+
+// NewSugenoEngine creates a new SugenoEngine using the Zadeh T-norm by
+// default.
+func NewSugenoEngine(rules []*SugenoRule) *SugenoEngine {
+	return &SugenoEngine{Rules: rules, TNorm: MinTNorm}
+}
+
+// Infer evaluates every rule against inputs and returns the weighted
+// average of their crisp outputs, weighted by firing strength. No
+// defuzzification step is needed since each rule already yields a crisp
+// value. It returns 0 if no rule fires.
+func (se *SugenoEngine) Infer(inputs map[string]float64) float64 {
+	weightedSum, totalWeight := 0.0, 0.0
+	for _, rule := range se.Rules {
+		strength := rule.FiringStrength(inputs, se.TNorm)
+		if strength <= 0 {
+			continue
+		}
+		weightedSum += strength * rule.Output(inputs)
+		totalWeight += strength
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return weightedSum / totalWeight
+}
@@ -0,0 +1,47 @@
+package gofuzzymind
+
+import "testing"
+
+func TestSugenoEngineZeroOrder(t *testing.T) {
+	low := NewFuzzySet("Low", StepDown(0, 10))
+	high := NewFuzzySet("High", StepUp(0, 10))
+
+	rules := []*SugenoRule{
+		NewSugenoRule(map[string]*FuzzySet{"x": low}, func(map[string]float64) float64 { return 0 }, 1),
+		NewSugenoRule(map[string]*FuzzySet{"x": high}, func(map[string]float64) float64 { return 100 }, 1),
+	}
+	engine := NewSugenoEngine(rules)
+
+	if got := engine.Infer(map[string]float64{"x": 0}); !almostEqual(got, 0, 1e-9) {
+		t.Errorf("Infer(x=0) = %v, want 0", got)
+	}
+	if got := engine.Infer(map[string]float64{"x": 10}); !almostEqual(got, 100, 1e-9) {
+		t.Errorf("Infer(x=10) = %v, want 100", got)
+	}
+	// x=5 fires both rules equally, so the weighted average is their midpoint.
+	if got := engine.Infer(map[string]float64{"x": 5}); !almostEqual(got, 50, 1e-9) {
+		t.Errorf("Infer(x=5) = %v, want 50", got)
+	}
+}
+
+func TestSugenoEngineFirstOrder(t *testing.T) {
+	everywhere := NewFuzzySet("All", func(float64) float64 { return 1 })
+	rule := NewSugenoRule(map[string]*FuzzySet{"x": everywhere}, func(inputs map[string]float64) float64 {
+		return 2*inputs["x"] + 1
+	}, 1)
+	engine := NewSugenoEngine([]*SugenoRule{rule})
+
+	if got := engine.Infer(map[string]float64{"x": 3}); !almostEqual(got, 7, 1e-9) {
+		t.Errorf("Infer(x=3) = %v, want 7", got)
+	}
+}
+
+func TestSugenoEngineNoRuleFires(t *testing.T) {
+	never := NewFuzzySet("Never", func(float64) float64 { return 0 })
+	rule := NewSugenoRule(map[string]*FuzzySet{"x": never}, func(map[string]float64) float64 { return 42 }, 1)
+	engine := NewSugenoEngine([]*SugenoRule{rule})
+
+	if got := engine.Infer(map[string]float64{"x": 0}); got != 0 {
+		t.Errorf("Infer with no rule fired = %v, want 0", got)
+	}
+}